@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// AuditLog is a single structured record of a user-mutation or login attempt,
+// kept so admins can investigate abuse.
+type AuditLog struct {
+	ID         uuid.UUID      `json:"id" gorm:"column:id;primaryKey"`
+	ActorID    *uuid.UUID     `json:"actor_id" gorm:"column:actor_id"`
+	Action     string         `json:"action" gorm:"column:action"`
+	TargetType string         `json:"target_type" gorm:"column:target_type"`
+	TargetID   string         `json:"target_id" gorm:"column:target_id"`
+	IP         string         `json:"ip" gorm:"column:ip"`
+	UserAgent  string         `json:"user_agent" gorm:"column:user_agent"`
+	Metadata   datatypes.JSON `json:"metadata" gorm:"column:metadata_jsonb"`
+	CreatedAt  time.Time      `json:"created_at" gorm:"column:created_at"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
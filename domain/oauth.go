@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserOAuthIdentity links a User to a single identity at an external OAuth2/OIDC provider.
+type UserOAuthIdentity struct {
+	ID        uuid.UUID `json:"id" gorm:"column:id"`
+	Provider  string    `json:"provider" gorm:"column:provider"`
+	Subject   string    `json:"subject" gorm:"column:subject"`
+	UserID    uuid.UUID `json:"user_id" gorm:"column:user_id"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
+}
+
+func (UserOAuthIdentity) TableName() string {
+	return "user_oauth_identities"
+}
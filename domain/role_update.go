@@ -0,0 +1,6 @@
+package domain
+
+// UserRoleUpdate is the payload accepted by PATCH /users/:id/role.
+type UserRoleUpdate struct {
+	Role Role `json:"role" binding:"required,oneof=user admin"`
+}
@@ -0,0 +1,11 @@
+package domain
+
+// TokenRefreshRequest is the payload for POST /users/refresh.
+type TokenRefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// UserLogout is the payload for POST /users/logout.
+type UserLogout struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
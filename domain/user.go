@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Role is a coarse-grained permission tier assigned to a User.
+type Role string
+
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+// User is a registered account.
+type User struct {
+	ID       uuid.UUID `json:"id" gorm:"column:id;primaryKey"`
+	Email    string    `json:"email" gorm:"column:email;unique"`
+	Password string    `json:"-" gorm:"column:password"`
+	Name     string    `json:"name" gorm:"column:name"`
+	Role     Role      `json:"role" gorm:"column:role"`
+
+	EmailVerifiedAt *time.Time `json:"email_verified_at" gorm:"column:email_verified_at"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+func (User) TableName() string {
+	return "users"
+}
+
+// UserCreate is the payload accepted by POST /users/register.
+type UserCreate struct {
+	ID       uuid.UUID `json:"id" gorm:"column:id"`
+	Email    string    `json:"email" binding:"required"`
+	Password string    `json:"password" binding:"required"`
+	Name     string    `json:"name" binding:"required"`
+}
+
+func (UserCreate) TableName() string {
+	return User{}.TableName()
+}
+
+// UserLogin is the payload accepted by POST /users/login.
+type UserLogin struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// UserUpdate is the partial payload accepted by PATCH /users/:id.
+type UserUpdate struct {
+	Name *string `json:"name"`
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VerificationPurpose distinguishes what a VerificationToken authorizes.
+type VerificationPurpose string
+
+const (
+	VerificationPurposeEmailVerify   VerificationPurpose = "email_verify"
+	VerificationPurposePasswordReset VerificationPurpose = "password_reset"
+)
+
+// VerificationToken is a single-use, expiring token proving control of an
+// email address. Only its SHA-256 hash is ever persisted; the raw token is
+// handed to the user once, in the verification/reset link.
+type VerificationToken struct {
+	ID        uuid.UUID           `json:"id" gorm:"column:id;primaryKey"`
+	UserID    uuid.UUID           `json:"user_id" gorm:"column:user_id"`
+	Purpose   VerificationPurpose `json:"purpose" gorm:"column:purpose"`
+	TokenHash string              `json:"-" gorm:"column:token_hash"`
+	ExpiresAt time.Time           `json:"expires_at" gorm:"column:expires_at"`
+	UsedAt    *time.Time          `json:"used_at" gorm:"column:used_at"`
+	CreatedAt time.Time           `json:"created_at" gorm:"column:created_at"`
+}
+
+func (VerificationToken) TableName() string {
+	return "verification_tokens"
+}
+
+// HashVerificationToken hashes a raw token for storage/lookup, so the raw
+// value itself is never persisted and a DB dump can't be replayed as a token.
+func HashVerificationToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// PasswordForgotRequest is the payload for POST /users/password/forgot.
+type PasswordForgotRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// PasswordResetRequest is the payload for POST /users/password/reset.
+type PasswordResetRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
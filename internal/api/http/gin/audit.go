@@ -0,0 +1,64 @@
+package gin
+
+import (
+	"net/http"
+	"todo-app/domain"
+	"todo-app/pkg/clients"
+	"todo-app/pkg/sessionstore"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuditService lists recorded audit events for the admin audit log endpoint.
+type AuditService interface {
+	GetAllAuditEvents(opts clients.ListOptions) (clients.ListPage[domain.AuditLog], error)
+}
+
+type auditHandler struct {
+	auditService AuditService
+}
+
+// NewAuditHandler wires up GET /admin/audit, restricted to admins.
+func NewAuditHandler(apiVersion *gin.RouterGroup, svc AuditService, sessions sessionstore.SessionStore, middlewareAuth func(c *gin.Context)) {
+	auditHandler := &auditHandler{
+		auditService: svc,
+	}
+
+	admin := apiVersion.Group("/admin")
+	admin.GET("/audit", middlewareAuth, middlewareRejectRevoked(sessions), middlewareRequireRole(domain.RoleAdmin), auditHandler.GetAllAuditEventsHandler)
+}
+
+// GetAllAuditEventsHandler retrieves a page of audit events.
+//
+// @Summary      Get all audit events
+// @Description  This endpoint retrieves a cursor-paginated list of audit events. Admin only.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        limit     query     int     false  "Max items to return (default 20, max 100)"
+// @Param        cursor    query     string  false  "Opaque cursor from a previous page's next_cursor"
+// @Param        actor_id  query     string  false  "Filter by actor ID"
+// @Param        action    query     string  false  "Filter by action, e.g. user.login.failure"
+// @Param        from      query     string  false  "Only events at or after this RFC3339 timestamp"
+// @Param        to        query     string  false  "Only events at or before this RFC3339 timestamp"
+// @Success      200  {object}  clients.SuccessRes  "List of audit events retrieved successfully"
+// @Failure      400  {object}  clients.AppError    "Bad Request - Invalid filter or cursor"
+// @Failure      500  {object}  clients.AppError    "Internal Server Error - Unexpected error"
+// @Router       /admin/audit [get]
+func (h *auditHandler) GetAllAuditEventsHandler(c *gin.Context) {
+	opts := parseListOptions(c)
+
+	for _, field := range []string{"actor_id", "action", "from", "to"} {
+		if value := c.Query(field); value != "" {
+			opts.Filters[field] = value
+		}
+	}
+
+	page, err := h.auditService.GetAllAuditEvents(opts)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(page))
+}
@@ -0,0 +1,68 @@
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+	"todo-app/pkg/clients"
+	"todo-app/pkg/sessionstore"
+
+	"github.com/gin-gonic/gin"
+)
+
+// jtiRequester is implemented by a clients.Requester that also carries the
+// jti of the access token it was built from, without forcing every Requester
+// implementation to have one.
+type jtiRequester interface {
+	GetJti() string
+}
+
+// expiringRequester is implemented by a clients.Requester that also knows
+// when its access token expires, so the token can be blacklisted for exactly
+// that long instead of an arbitrary TTL.
+type expiringRequester interface {
+	GetExpiresAt() time.Time
+}
+
+// middlewareRejectRevoked aborts the request with 401 if the authenticated
+// caller's access token jti has been revoked (e.g. by a prior logout). It
+// must run after middlewareAuth, since it reads clients.CurrentUser.
+func middlewareRejectRevoked(store sessionstore.SessionStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requester := c.MustGet(clients.CurrentUser).(clients.Requester)
+
+		rr, ok := requester.(jtiRequester)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		revoked, err := store.IsRevoked(rr.GetJti())
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, clients.ErrInternal(err))
+			return
+		}
+		if revoked {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, clients.ErrInvalidRequest(fmt.Errorf("unauthorized: token has been revoked")))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// revokeCurrentAccessToken blacklists the access token behind requester, if
+// it carries a jti and an expiry, so middlewareRejectRevoked starts rejecting
+// it immediately instead of waiting for it to expire naturally.
+func revokeCurrentAccessToken(store sessionstore.SessionStore, requester clients.Requester) error {
+	rr, ok := requester.(jtiRequester)
+	if !ok {
+		return nil
+	}
+	er, ok := requester.(expiringRequester)
+	if !ok {
+		return nil
+	}
+
+	return store.Revoke(rr.GetJti(), er.GetExpiresAt())
+}
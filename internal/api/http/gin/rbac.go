@@ -0,0 +1,60 @@
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"todo-app/domain"
+	"todo-app/pkg/clients"
+	"todo-app/pkg/rbac"
+
+	"github.com/gin-gonic/gin"
+)
+
+// roleRequester is implemented by any clients.Requester that also carries the
+// caller's role, without forcing every Requester implementation to have one.
+type roleRequester interface {
+	GetRole() domain.Role
+}
+
+func requesterRole(c *gin.Context) domain.Role {
+	requester := c.MustGet(clients.CurrentUser).(clients.Requester)
+
+	rr, ok := requester.(roleRequester)
+	if !ok {
+		return ""
+	}
+
+	return rr.GetRole()
+}
+
+// middlewareRequireRole aborts the request with 403 unless the authenticated
+// caller's role is one of roles. Must run after middlewareAuth.
+func middlewareRequireRole(roles ...domain.Role) gin.HandlerFunc {
+	allowed := make(map[domain.Role]bool, len(roles))
+	for _, role := range roles {
+		allowed[role] = true
+	}
+
+	return func(c *gin.Context) {
+		if !allowed[requesterRole(c)] {
+			c.AbortWithStatusJSON(http.StatusForbidden, clients.ErrInvalidRequest(fmt.Errorf("forbidden: requires role %v", roles)))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// middlewareRequirePermission aborts the request with 403 unless registry
+// grants the authenticated caller's role permission to perform action on
+// resource. Must run after middlewareAuth.
+func middlewareRequirePermission(registry *rbac.Registry, resource string, action rbac.Action) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !registry.IsAllowed(string(requesterRole(c)), resource, action) {
+			c.AbortWithStatusJSON(http.StatusForbidden, clients.ErrInvalidRequest(fmt.Errorf("forbidden: %s %s not permitted for role", action, resource)))
+			return
+		}
+
+		c.Next()
+	}
+}
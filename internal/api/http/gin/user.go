@@ -1,41 +1,91 @@
 package gin
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"todo-app/domain"
+	"todo-app/pkg/audit"
 	"todo-app/pkg/clients"
+	"todo-app/pkg/oauth"
+	"todo-app/pkg/rbac"
+	"todo-app/pkg/sessionstore"
 	"todo-app/pkg/tokenprovider"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
+const oauthStateCookie = "oauth_state"
+
 type UserService interface {
 	Register(data *domain.UserCreate) error
-	Login(data *domain.UserLogin) (tokenprovider.Token, error)
-	GetAllUser() ([]domain.User, error)
+	Login(data *domain.UserLogin) (tokenprovider.TokenPair, error)
+	LoginWithOAuth(provider string, code string) (tokenprovider.TokenPair, error)
+	RefreshToken(refreshToken string) (tokenprovider.TokenPair, error)
+	Logout(userID uuid.UUID, refreshToken string) error
+	GetAllUser(opts clients.ListOptions) (clients.ListPage[domain.User], error)
 	GetUserByID(id uuid.UUID) (domain.User, error)
 	UpdateUser(id uuid.UUID, user *domain.UserUpdate) error
+	UpdateUserRole(id uuid.UUID, role domain.Role) error
 	DeleteUser(id uuid.UUID) error
+	RequestEmailVerification(userID uuid.UUID) error
+	ConfirmEmail(token string) error
+	RequestPasswordReset(email string) error
+	ResetPassword(token string, newPassword string) error
 }
 
 type userHandler struct {
-	userService UserService
+	userService    UserService
+	oauthProviders oauth.Registry
+	rbacRegistry   *rbac.Registry
+	inviteOnly     bool
+	auditor        audit.Auditor
+	sessions       sessionstore.SessionStore
 }
 
-func NewUserHandler(apiVersion *gin.RouterGroup, svc UserService, middlewareAuth func(c *gin.Context), middlewareRateLimit func(c *gin.Context)) {
+// NewUserHandler wires up the /users routes. When inviteOnly is true,
+// self-service registration is disabled and only an admin may create
+// new accounts, e.g. to bootstrap invite-only deployments. sessions backs
+// access-token revocation: every authenticated route also runs
+// middlewareRejectRevoked so a logged-out token stops working immediately
+// instead of lingering until it naturally expires.
+func NewUserHandler(apiVersion *gin.RouterGroup, svc UserService, oauthProviders oauth.Registry, rbacRegistry *rbac.Registry, inviteOnly bool, auditor audit.Auditor, sessions sessionstore.SessionStore, middlewareAuth func(c *gin.Context), middlewareRateLimit func(c *gin.Context)) {
 	userHandler := &userHandler{
-		userService: svc,
+		userService:    svc,
+		oauthProviders: oauthProviders,
+		rbacRegistry:   rbacRegistry,
+		inviteOnly:     inviteOnly,
+		auditor:        auditor,
+		sessions:       sessions,
 	}
 
+	rejectRevoked := middlewareRejectRevoked(sessions)
+
 	users := apiVersion.Group("/users")
-	users.POST("/register", userHandler.RegisterUserHandler)
+	if inviteOnly {
+		users.POST("/register", middlewareAuth, rejectRevoked, middlewareRequireRole(domain.RoleAdmin), userHandler.RegisterUserHandler)
+	} else {
+		users.POST("/register", userHandler.RegisterUserHandler)
+	}
 	users.POST("/login", userHandler.LoginHandler)
-	users.GET("", middlewareAuth, userHandler.GetAllUserHandler)
-	users.GET("/:id", middlewareAuth, userHandler.GetUserHandler)
-	users.PATCH("/:id", middlewareAuth, userHandler.UpdateUserHandler)
-	users.DELETE("/:id", middlewareAuth, userHandler.DeleteUserHandler)
+	users.POST("/refresh", userHandler.RefreshTokenHandler)
+	users.POST("/logout", middlewareAuth, rejectRevoked, userHandler.LogoutHandler)
+	users.GET("/oauth/:provider/login", userHandler.OAuthLoginHandler)
+	users.GET("/oauth/:provider/callback", userHandler.OAuthCallbackHandler)
+	users.GET("", middlewareAuth, rejectRevoked, middlewareRequirePermission(rbacRegistry, "user", rbac.ActionList), userHandler.GetAllUserHandler)
+	users.GET("/:id", middlewareAuth, rejectRevoked, userHandler.GetUserHandler)
+	users.PATCH("/:id", middlewareAuth, rejectRevoked, userHandler.UpdateUserHandler)
+	users.PATCH("/:id/role", middlewareAuth, rejectRevoked, middlewareRequireRole(domain.RoleAdmin), userHandler.UpdateUserRoleHandler)
+	users.DELETE("/:id", middlewareAuth, rejectRevoked, userHandler.DeleteUserHandler)
+	users.POST("/verify/request", middlewareAuth, rejectRevoked, userHandler.RequestEmailVerificationHandler)
+	users.GET("/verify/confirm", userHandler.ConfirmEmailHandler)
+	users.POST("/password/forgot", userHandler.ForgotPasswordHandler)
+	users.POST("/password/reset", userHandler.ResetPasswordHandler)
 }
 
 // RegisterUserHandler handles user registration.
@@ -66,13 +116,15 @@ func (h *userHandler) RegisterUserHandler(c *gin.Context) {
 		return
 	}
 
+	h.recordAudit(c, "user.register", "user", data.ID.String(), nil)
+
 	c.JSON(http.StatusCreated, clients.SimpleSuccessResponse(data.ID))
 }
 
 // LoginHandler handles user login.
 //
 // @Summary      User login
-// @Description  This endpoint allows users to log in using their credentials and receive an authentication token.
+// @Description  This endpoint allows users to log in using their credentials and receive an access/refresh token pair.
 // @Tags         Users
 // @Accept       json
 // @Produce      json
@@ -89,33 +141,143 @@ func (h *userHandler) LoginHandler(c *gin.Context) {
 		return
 	}
 
-	token, err := h.userService.Login(&data)
+	tokens, err := h.userService.Login(&data)
+	if err != nil {
+		h.recordAudit(c, "user.login.failure", "user", data.Email, nil)
+		c.JSON(http.StatusBadRequest, err)
+		return
+	}
+
+	h.recordAudit(c, "user.login.success", "user", data.Email, nil)
+
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(tokens))
+}
+
+// RefreshTokenHandler exchanges a valid refresh token for a new access/refresh token pair.
+//
+// @Summary      Refresh an access token
+// @Description  This endpoint issues a new access/refresh token pair for a still-valid, unrevoked refresh token.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        payload  body      domain.TokenRefreshRequest  true  "Refresh token payload"
+// @Success      200      {object}  clients.SuccessRes   "New token pair issued"
+// @Failure      400      {object}  clients.AppError     "Bad Request - Invalid or expired refresh token"
+// @Failure      500      {object}  clients.AppError     "Internal Server Error - Unexpected error"
+// @Router       /users/refresh [post]
+func (h *userHandler) RefreshTokenHandler(c *gin.Context) {
+	var data domain.TokenRefreshRequest
+
+	if err := c.ShouldBind(&data); err != nil {
+		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(err))
+		return
+	}
+
+	tokens, err := h.userService.RefreshToken(data.RefreshToken)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(token))
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(tokens))
+}
+
+// LogoutHandler revokes the caller's active session so its tokens can no longer be used.
+//
+// @Summary      User logout
+// @Description  This endpoint revokes the caller's refresh token and blacklists the current access token's jti.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        payload  body      domain.UserLogout  true  "Logout payload"
+// @Success      200      {object}  clients.SuccessRes   "User successfully logged out"
+// @Failure      400      {object}  clients.AppError     "Bad Request - Invalid refresh token"
+// @Failure      500      {object}  clients.AppError     "Internal Server Error - Unexpected error"
+// @Router       /users/logout [post]
+func (h *userHandler) LogoutHandler(c *gin.Context) {
+	var data domain.UserLogout
+
+	if err := c.ShouldBind(&data); err != nil {
+		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(err))
+		return
+	}
+
+	requester := c.MustGet(clients.CurrentUser).(clients.Requester)
+
+	if err := h.userService.Logout(requester.GetUserID(), data.RefreshToken); err != nil {
+		c.JSON(http.StatusBadRequest, err)
+		return
+	}
+
+	if err := revokeCurrentAccessToken(h.sessions, requester); err != nil {
+		c.JSON(http.StatusInternalServerError, clients.ErrInternal(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(true))
 }
 
-// GetAllUserHandler retrieves all users.
+// GetAllUserHandler retrieves a page of users.
 //
 // @Summary      Get all users
-// @Description  This endpoint retrieves a list of all registered users. It is accessible only to admin users.
+// @Description  This endpoint retrieves a cursor-paginated, sortable, filterable list of registered users. It is accessible only to admin users.
 // @Tags         Users
 // @Accept       json
 // @Produce      json
+// @Param        limit   query     int     false  "Max items to return (default 20, max 100)"
+// @Param        cursor  query     string  false  "Opaque cursor from a previous page's next_cursor/prev_cursor"
+// @Param        direction  query  string  false  "Which side of cursor to read: next (default) or prev"
+// @Param        sort    query     string  false  "Column and direction, e.g. created_at:desc"
 // @Success      200  {object}  clients.SuccessRes  "List of users retrieved successfully"
+// @Failure      400  {object}  clients.AppError    "Bad Request - Invalid sort/filter field or cursor"
 // @Failure      500  {object}  clients.AppError    "Internal Server Error - Unexpected error"
 // @Router       /users [get]
 func (h *userHandler) GetAllUserHandler(c *gin.Context) {
-	items, err := h.userService.GetAllUser()
+	page, err := h.userService.GetAllUser(parseListOptions(c))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(err))
+		c.JSON(http.StatusBadRequest, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(items))
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(page))
+}
+
+// parseListOptions builds a clients.ListOptions from ?limit=&cursor=&sort=field:dir&filter[field]=value.
+func parseListOptions(c *gin.Context) clients.ListOptions {
+	opts := clients.ListOptions{
+		Cursor:  c.Query("cursor"),
+		Filters: map[string]any{},
+	}
+
+	if limit, err := strconv.Atoi(c.Query("limit")); err == nil {
+		opts.Limit = limit
+	}
+
+	if strings.EqualFold(c.Query("direction"), "prev") {
+		opts.Direction = clients.DirectionPrev
+	} else {
+		opts.Direction = clients.DirectionNext
+	}
+
+	if sort := c.Query("sort"); sort != "" {
+		field, dir, _ := strings.Cut(sort, ":")
+		opts.Sort = append(opts.Sort, clients.SortField{
+			Field: field,
+			Desc:  strings.EqualFold(dir, "desc"),
+		})
+	}
+
+	for key, values := range c.Request.URL.Query() {
+		if len(values) == 0 {
+			continue
+		}
+		if field, ok := strings.CutPrefix(key, "filter["); ok {
+			field = strings.TrimSuffix(field, "]")
+			opts.Filters[field] = values[0]
+		}
+	}
+
+	return opts
 }
 
 // GetUserHandler retrieves a user by ID.
@@ -179,12 +341,8 @@ func (h *userHandler) UpdateUserHandler(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(err))
 		return
 	}
-	var user1 domain.User
-	requester := c.MustGet(clients.CurrentUser).(clients.Requester)
-	user1.ID = requester.GetUserID()
-
-	if user1.ID != id {
-		c.JSON(http.StatusUnauthorized, clients.ErrInvalidRequest(fmt.Errorf("unauthorized: ID does not match")))
+	if !h.isOwnerOrAdmin(c, id) {
+		c.JSON(http.StatusUnauthorized, clients.ErrInvalidRequest(fmt.Errorf("unauthorized: must be an admin or the account owner")))
 		return
 	}
 
@@ -193,13 +351,15 @@ func (h *userHandler) UpdateUserHandler(c *gin.Context) {
 		return
 	}
 
+	h.recordAudit(c, "user.update", "user", id.String(), nil)
+
 	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(true))
 }
 
 // DeleteUserHandler deletes a user by ID.
 //
 // @Summary      Delete a user
-// @Description  This endpoint deletes a user identified by their unique ID.
+// @Description  This endpoint deletes a user identified by their unique ID. Callers must be an admin or the account owner.
 //
 //	If the user is not found, an appropriate error message is returned.
 //
@@ -209,6 +369,7 @@ func (h *userHandler) UpdateUserHandler(c *gin.Context) {
 // @Param        id   path      string                 true  "User ID"
 // @Success      200  {object}  clients.SuccessRes     "User deleted successfully"
 // @Failure      400  {object}  clients.AppError       "Invalid ID format or bad request"
+// @Failure      401  {object}  clients.AppError       "Unauthorized - not an admin or the account owner"
 // @Failure      404  {object}  clients.AppError       "User not found"
 // @Failure      500  {object}  clients.AppError       "Internal Server Error - Unexpected error"
 // @Router       /users/{id} [delete]
@@ -219,10 +380,264 @@ func (h *userHandler) DeleteUserHandler(c *gin.Context) {
 		return
 	}
 
+	if !h.isOwnerOrAdmin(c, id) {
+		c.JSON(http.StatusUnauthorized, clients.ErrInvalidRequest(fmt.Errorf("unauthorized: must be an admin or the account owner")))
+		return
+	}
+
 	if err := h.userService.DeleteUser(id); err != nil {
 		c.JSON(http.StatusBadRequest, err)
 		return
 	}
 
+	h.recordAudit(c, "user.delete", "user", id.String(), nil)
+
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(true))
+}
+
+// UpdateUserRoleHandler changes a user's role. Admin-only.
+//
+// @Summary      Update a user's role
+// @Description  This endpoint promotes or demotes a user between the "user" and "admin" roles.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                  true  "User ID"
+// @Param        role  body      domain.UserRoleUpdate    true  "New role"
+// @Success      200   {object}  clients.SuccessRes     "Role updated successfully"
+// @Failure      400   {object}  clients.AppError       "Invalid ID format, role, or bad request"
+// @Failure      404   {object}  clients.AppError       "User not found"
+// @Failure      500   {object}  clients.AppError       "Internal Server Error - Unexpected error"
+// @Router       /users/{id}/role [patch]
+func (h *userHandler) UpdateUserRoleHandler(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(err))
+		return
+	}
+
+	var data domain.UserRoleUpdate
+	if err := c.ShouldBind(&data); err != nil {
+		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(err))
+		return
+	}
+
+	if err := h.userService.UpdateUserRole(id, data.Role); err != nil {
+		c.JSON(http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(true))
+}
+
+// isOwnerOrAdmin reports whether the authenticated caller is either id itself
+// or holds the admin role, replacing the old inline "ID does not match" check.
+func (h *userHandler) isOwnerOrAdmin(c *gin.Context, id uuid.UUID) bool {
+	requester := c.MustGet(clients.CurrentUser).(clients.Requester)
+	if requester.GetUserID() == id {
+		return true
+	}
+
+	return requesterRole(c) == domain.RoleAdmin
+}
+
+// currentActorID returns the authenticated caller's ID, or nil on routes that
+// run before auth (register, login) where there is no actor yet.
+func (h *userHandler) currentActorID(c *gin.Context) *uuid.UUID {
+	val, exists := c.Get(clients.CurrentUser)
+	if !exists {
+		return nil
+	}
+
+	requester, ok := val.(clients.Requester)
+	if !ok {
+		return nil
+	}
+
+	id := requester.GetUserID()
+	return &id
+}
+
+// recordAudit records an audit event for action, best-effort: a failure to
+// write the audit log must never fail the request that triggered it.
+func (h *userHandler) recordAudit(c *gin.Context, action, targetType, targetID string, metadata map[string]any) {
+	_ = h.auditor.Record(c.Request.Context(), audit.AuditEvent{
+		ActorID:    h.currentActorID(c),
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		IP:         c.ClientIP(),
+		UserAgent:  c.Request.UserAgent(),
+		Metadata:   metadata,
+	})
+}
+
+// OAuthLoginHandler redirects the caller to the given provider's authorize URL.
+//
+// @Summary      Start an OAuth2 login
+// @Description  This endpoint sets a state cookie and redirects to the provider's authorize URL.
+// @Tags         Users
+// @Param        provider  path  string  true  "OAuth provider, e.g. google or github"
+// @Success      307       "Redirect to the provider's authorize URL"
+// @Failure      400       {object}  clients.AppError  "Unknown provider"
+// @Router       /users/oauth/{provider}/login [get]
+func (h *userHandler) OAuthLoginHandler(c *gin.Context) {
+	provider, err := h.oauthProviders.Get(c.Param("provider"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(err))
+		return
+	}
+
+	state, err := newOAuthState()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, clients.ErrInternal(err))
+		return
+	}
+
+	c.SetCookie(oauthStateCookie, state, int(oauthStateTTLSeconds), "/", "", true, true)
+	c.Redirect(http.StatusTemporaryRedirect, provider.AuthURL(state))
+}
+
+// OAuthCallbackHandler completes the OAuth2 flow and logs the user in.
+//
+// @Summary      Complete an OAuth2 login
+// @Description  This endpoint validates the state cookie, exchanges the code, and returns a token pair.
+// @Tags         Users
+// @Param        provider  path      string  true  "OAuth provider, e.g. google or github"
+// @Param        code      query     string  true  "Authorization code"
+// @Param        state     query     string  true  "State returned from the authorize redirect"
+// @Success      200       {object}  clients.SuccessRes   "User successfully logged in"
+// @Failure      400       {object}  clients.AppError     "Bad Request - Invalid code or state mismatch"
+// @Failure      500       {object}  clients.AppError     "Internal Server Error - Unexpected error"
+// @Router       /users/oauth/{provider}/callback [get]
+func (h *userHandler) OAuthCallbackHandler(c *gin.Context) {
+	providerName := c.Param("provider")
+	if _, err := h.oauthProviders.Get(providerName); err != nil {
+		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(err))
+		return
+	}
+
+	cookieState, err := c.Cookie(oauthStateCookie)
+	if err != nil || cookieState == "" || cookieState != c.Query("state") {
+		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(fmt.Errorf("oauth: state mismatch")))
+		return
+	}
+	c.SetCookie(oauthStateCookie, "", -1, "/", "", true, true)
+
+	tokens, err := h.userService.LoginWithOAuth(providerName, c.Query("code"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(tokens))
+}
+
+const oauthStateTTLSeconds = 10 * 60
+
+func newOAuthState() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// RequestEmailVerificationHandler sends the caller a fresh email-verification link.
+//
+// @Summary      Request email verification
+// @Description  This endpoint emails the authenticated user a link to confirm their address.
+// @Tags         Users
+// @Produce      json
+// @Success      200  {object}  clients.SuccessRes   "Verification email sent"
+// @Failure      500  {object}  clients.AppError      "Internal Server Error - Unexpected error"
+// @Router       /users/verify/request [post]
+func (h *userHandler) RequestEmailVerificationHandler(c *gin.Context) {
+	requester := c.MustGet(clients.CurrentUser).(clients.Requester)
+
+	if err := h.userService.RequestEmailVerification(requester.GetUserID()); err != nil {
+		c.JSON(http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(true))
+}
+
+// ConfirmEmailHandler marks the email address tied to the given token as verified.
+//
+// @Summary      Confirm an email verification token
+// @Description  This endpoint consumes a verification token and marks the owning user's email as verified.
+// @Tags         Users
+// @Produce      json
+// @Param        token  query     string  true  "Verification token"
+// @Success      200    {object}  clients.SuccessRes   "Email confirmed"
+// @Failure      400    {object}  clients.AppError      "Bad Request - Invalid or expired token"
+// @Failure      500    {object}  clients.AppError      "Internal Server Error - Unexpected error"
+// @Router       /users/verify/confirm [get]
+func (h *userHandler) ConfirmEmailHandler(c *gin.Context) {
+	if err := h.userService.ConfirmEmail(c.Query("token")); err != nil {
+		c.JSON(http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(true))
+}
+
+// ForgotPasswordHandler sends a password-reset link if the email belongs to a user.
+//
+// @Summary      Request a password reset
+// @Description  This endpoint emails a password-reset link. It always returns 200 to avoid leaking whether an email is registered.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        payload  body      domain.PasswordForgotRequest  true  "Email to send the reset link to"
+// @Success      200      {object}  clients.SuccessRes   "Reset email sent if the account exists"
+// @Failure      400      {object}  clients.AppError      "Bad Request - Invalid payload"
+// @Failure      500      {object}  clients.AppError      "Internal Server Error - Unexpected error"
+// @Router       /users/password/forgot [post]
+func (h *userHandler) ForgotPasswordHandler(c *gin.Context) {
+	var data domain.PasswordForgotRequest
+
+	if err := c.ShouldBind(&data); err != nil {
+		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(err))
+		return
+	}
+
+	if err := h.userService.RequestPasswordReset(data.Email); err != nil && !errors.Is(err, clients.ErrRecordNotFound) {
+		c.JSON(http.StatusInternalServerError, clients.ErrInternal(err))
+		return
+	}
+
+	// Always 200, whether or not the email belongs to an account, so this
+	// endpoint can't be used to enumerate registered addresses.
+	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(true))
+}
+
+// ResetPasswordHandler consumes a password-reset token and sets a new password.
+//
+// @Summary      Reset a password
+// @Description  This endpoint validates a password-reset token and updates the owning user's password.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        payload  body      domain.PasswordResetRequest  true  "Reset token and new password"
+// @Success      200      {object}  clients.SuccessRes   "Password updated"
+// @Failure      400      {object}  clients.AppError      "Bad Request - Invalid or expired token"
+// @Failure      500      {object}  clients.AppError      "Internal Server Error - Unexpected error"
+// @Router       /users/password/reset [post]
+func (h *userHandler) ResetPasswordHandler(c *gin.Context) {
+	var data domain.PasswordResetRequest
+
+	if err := c.ShouldBind(&data); err != nil {
+		c.JSON(http.StatusBadRequest, clients.ErrInvalidRequest(err))
+		return
+	}
+
+	if err := h.userService.ResetPassword(data.Token, data.NewPassword); err != nil {
+		c.JSON(http.StatusBadRequest, err)
+		return
+	}
+
 	c.JSON(http.StatusOK, clients.SimpleSuccessResponse(true))
 }
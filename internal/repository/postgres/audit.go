@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+	"todo-app/domain"
+	"todo-app/pkg/clients"
+
+	"gorm.io/gorm"
+)
+
+type auditRepo struct {
+	db *gorm.DB
+}
+
+func NewAuditRepo(db *gorm.DB) *auditRepo {
+	return &auditRepo{
+		db: db,
+	}
+}
+
+var auditFilterableColumns = map[string]bool{"actor_id": true, "action": true}
+
+// GetAll returns a cursor-paginated page of audit logs, newest first, filtered
+// by opts.Filters (actor_id, action) and the opts.Filters["from"]/["to"]
+// created_at bounds set by the handler from the from/to query params.
+func (r *auditRepo) GetAll(opts clients.ListOptions) (clients.ListPage[domain.AuditLog], error) {
+	query := r.db.Model(&domain.AuditLog{}).Order("created_at DESC, id DESC")
+
+	for field, value := range opts.Filters {
+		switch field {
+		case "from":
+			query = query.Where("created_at >= ?", value)
+		case "to":
+			query = query.Where("created_at <= ?", value)
+		default:
+			if !auditFilterableColumns[field] {
+				return clients.ListPage[domain.AuditLog]{}, clients.ErrInvalidRequest(fmt.Errorf("filter field %q is not allowed", field))
+			}
+			query = query.Where(fmt.Sprintf("%s = ?", field), value)
+		}
+	}
+
+	if opts.Cursor != "" {
+		sortValue, id, err := clients.DecodeCursor(opts.Cursor)
+		if err != nil {
+			return clients.ListPage[domain.AuditLog]{}, err
+		}
+		query = query.Where("(created_at, id) < (?, ?)", sortValue, id)
+	}
+
+	limit := opts.Limit
+	if limit <= 0 || limit > 100 {
+		limit = defaultUserPageLimit
+	}
+
+	logs := []domain.AuditLog{}
+	if err := query.Limit(limit + 1).Find(&logs).Error; err != nil {
+		return clients.ListPage[domain.AuditLog]{}, clients.ErrDB(err)
+	}
+
+	page := clients.ListPage[domain.AuditLog]{Items: logs}
+	if len(logs) > limit {
+		page.Items = logs[:limit]
+		last := page.Items[len(page.Items)-1]
+		page.NextCursor = clients.EncodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.ID)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"errors"
+	"todo-app/domain"
+	"todo-app/pkg/clients"
+
+	"gorm.io/gorm"
+)
+
+// SeedFirstAdmin promotes the earliest-registered user to domain.RoleAdmin if
+// no admin exists yet. It is meant to be called once at startup so a fresh
+// deployment always has at least one admin able to use the role-management
+// and admin-only endpoints.
+func SeedFirstAdmin(db *gorm.DB) error {
+	var adminCount int64
+	if err := db.Model(&domain.User{}).Where("role = ?", domain.RoleAdmin).Count(&adminCount).Error; err != nil {
+		return clients.ErrDB(err)
+	}
+	if adminCount > 0 {
+		return nil
+	}
+
+	var firstUser domain.User
+	if err := db.Order("created_at ASC, id ASC").First(&firstUser).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		return clients.ErrDB(err)
+	}
+
+	if err := db.Model(&firstUser).Update("role", domain.RoleAdmin).Error; err != nil {
+		return clients.ErrDB(err)
+	}
+
+	return nil
+}
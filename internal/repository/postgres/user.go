@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"errors"
+	"fmt"
+	"time"
 	"todo-app/domain"
 	"todo-app/pkg/clients"
 
@@ -40,14 +42,117 @@ func (r *userRepo) GetUser(conditions map[string]any) (*domain.User, error) {
 
 	return &user, nil
 }
-func (r *userRepo) GetAll() ([]domain.User, error) {
+
+// userSortableColumns and userFilterableColumns whitelist the columns GetAll
+// accepts from ListOptions, since sort/filter field names are interpolated
+// into the query and must never come from user input unchecked.
+var (
+	userSortableColumns   = map[string]bool{"created_at": true, "id": true, "email": true, "name": true}
+	userFilterableColumns = map[string]bool{"email": true, "name": true}
+)
+
+const defaultUserPageLimit = 20
+
+func (r *userRepo) GetAll(opts clients.ListOptions) (clients.ListPage[domain.User], error) {
+	sortField := "created_at"
+	desc := true
+	if len(opts.Sort) > 0 {
+		if !userSortableColumns[opts.Sort[0].Field] {
+			return clients.ListPage[domain.User]{}, clients.ErrInvalidRequest(fmt.Errorf("sort field %q is not allowed", opts.Sort[0].Field))
+		}
+		sortField = opts.Sort[0].Field
+		desc = opts.Sort[0].Desc
+	}
+
+	query := r.db.Model(&domain.User{})
+
+	for field, value := range opts.Filters {
+		if !userFilterableColumns[field] {
+			return clients.ListPage[domain.User]{}, clients.ErrInvalidRequest(fmt.Errorf("filter field %q is not allowed", field))
+		}
+		query = query.Where(fmt.Sprintf("%s = ?", field), value)
+	}
+
+	direction := opts.Direction
+	if opts.Cursor == "" {
+		direction = clients.DirectionNext
+	}
+
+	queryDesc, cmp := clients.SeekPredicate(desc, direction)
+	order := fmt.Sprintf("%s DESC, id DESC", sortField)
+	if !queryDesc {
+		order = fmt.Sprintf("%s ASC, id ASC", sortField)
+	}
+	query = query.Order(order)
+
+	if opts.Cursor != "" {
+		sortValue, id, err := clients.DecodeCursor(opts.Cursor)
+		if err != nil {
+			return clients.ListPage[domain.User]{}, err
+		}
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", sortField, cmp), sortValue, id)
+	}
+
+	limit := opts.Limit
+	if limit <= 0 || limit > 100 {
+		limit = defaultUserPageLimit
+	}
+
 	users := []domain.User{}
+	if err := query.Limit(limit + 1).Find(&users).Error; err != nil {
+		return clients.ListPage[domain.User]{}, clients.ErrDB(err)
+	}
 
-	if err := r.db.Find(&users).Error; err != nil {
-		return nil, clients.ErrDB(err)
+	hasMore := len(users) > limit
+	if hasMore {
+		users = users[:limit]
 	}
 
-	return users, nil
+	// A DirectionPrev query walks backwards in queryDesc order to land on the
+	// rows immediately before the cursor; reverse them back into display order.
+	if direction == clients.DirectionPrev {
+		for i, j := 0, len(users)-1; i < j; i, j = i+1, j-1 {
+			users[i], users[j] = users[j], users[i]
+		}
+	}
+
+	page := clients.ListPage[domain.User]{Items: users}
+	if len(users) == 0 {
+		return page, nil
+	}
+
+	first := users[0]
+	last := users[len(users)-1]
+
+	if direction == clients.DirectionPrev {
+		if hasMore {
+			page.PrevCursor = clients.EncodeCursor(userSortValue(first, sortField), first.ID)
+		}
+		page.NextCursor = clients.EncodeCursor(userSortValue(last, sortField), last.ID)
+	} else {
+		if opts.Cursor != "" {
+			page.PrevCursor = clients.EncodeCursor(userSortValue(first, sortField), first.ID)
+		}
+		if hasMore {
+			page.NextCursor = clients.EncodeCursor(userSortValue(last, sortField), last.ID)
+		}
+	}
+
+	return page, nil
+}
+
+// userSortValue returns the string form of field on user, for embedding in a cursor.
+func userSortValue(user domain.User, field string) string {
+	switch field {
+	case "email":
+		return user.Email
+	case "name":
+		return user.Name
+	case "id":
+		return user.ID.String()
+	default:
+		return user.CreatedAt.Format(time.RFC3339Nano)
+	}
 }
 
 func (r *userRepo) GetByID(id uuid.UUID) (domain.User, error) {
@@ -72,6 +177,14 @@ func (r *userRepo) Update(id uuid.UUID, user *domain.UserUpdate) error {
 	return nil
 }
 
+func (r *userRepo) UpdateRole(id uuid.UUID, role domain.Role) error {
+	if err := r.db.Model(&domain.User{}).Where("id = ?", id).Update("role", role).Error; err != nil {
+		return clients.ErrDB(err)
+	}
+
+	return nil
+}
+
 func (r *userRepo) Delete(id uuid.UUID) error {
 	if err := r.db.Table(domain.User{}.TableName()).Where("id = ?", id).Delete(nil).Error; err != nil {
 		return clients.ErrDB(err)
@@ -79,3 +192,46 @@ func (r *userRepo) Delete(id uuid.UUID) error {
 
 	return nil
 }
+
+// GetOrCreateFromOAuth links an external OAuth2 identity to a local user,
+// creating the user (and the identity row) on first sign-in for a given
+// provider+subject, and linking to an existing user found by email otherwise.
+func (r *userRepo) GetOrCreateFromOAuth(provider, subject, email, name string) (domain.User, error) {
+	var identity domain.UserOAuthIdentity
+
+	err := r.db.Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error
+	if err == nil {
+		return r.GetByID(identity.UserID)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.User{}, clients.ErrDB(err)
+	}
+
+	var user domain.User
+
+	txErr := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("email = ?", email).First(&user).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			user = domain.User{ID: uuid.New(), Email: email, Name: name, Role: domain.RoleUser}
+			if err := tx.Create(&user).Error; err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+
+		identity = domain.UserOAuthIdentity{
+			ID:       uuid.New(),
+			Provider: provider,
+			Subject:  subject,
+			UserID:   user.ID,
+		}
+
+		return tx.Create(&identity).Error
+	})
+	if txErr != nil {
+		return domain.User{}, clients.ErrDB(txErr)
+	}
+
+	return user, nil
+}
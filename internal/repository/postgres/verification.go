@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"errors"
+	"time"
+	"todo-app/domain"
+	"todo-app/pkg/clients"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type verificationRepo struct {
+	db *gorm.DB
+}
+
+func NewVerificationRepo(db *gorm.DB) *verificationRepo {
+	return &verificationRepo{
+		db: db,
+	}
+}
+
+func (r *verificationRepo) Save(token *domain.VerificationToken) error {
+	if err := r.db.Create(token).Error; err != nil {
+		return clients.ErrDB(err)
+	}
+
+	return nil
+}
+
+// GetValidByHash returns the unused, unexpired token for the given hash and
+// purpose, or clients.ErrRecordNotFound if none exists.
+func (r *verificationRepo) GetValidByHash(tokenHash string, purpose domain.VerificationPurpose) (*domain.VerificationToken, error) {
+	var token domain.VerificationToken
+
+	err := r.db.
+		Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", tokenHash, purpose, time.Now()).
+		First(&token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, clients.ErrRecordNotFound
+		}
+
+		return nil, clients.ErrDB(err)
+	}
+
+	return &token, nil
+}
+
+func (r *verificationRepo) MarkUsed(id uuid.UUID) error {
+	if err := r.db.Model(&domain.VerificationToken{}).Where("id = ?", id).Update("used_at", time.Now()).Error; err != nil {
+		return clients.ErrDB(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// AuditEvent describes a single user-mutation or login attempt to record.
+type AuditEvent struct {
+	ActorID    *uuid.UUID     `json:"actor_id"`
+	Action     string         `json:"action"`
+	TargetType string         `json:"target_type"`
+	TargetID   string         `json:"target_id"`
+	IP         string         `json:"ip"`
+	UserAgent  string         `json:"user_agent"`
+	Metadata   map[string]any `json:"metadata,omitempty"`
+}
+
+// Auditor persists audit events. Implementations must not let a failure to
+// record block the request that triggered the event.
+type Auditor interface {
+	Record(ctx context.Context, event AuditEvent) error
+}
+
+// Sink receives a copy of every recorded event, e.g. to ship it to a log
+// aggregator without putting that path's latency on the DB write.
+type Sink interface {
+	Write(event AuditEvent)
+}
@@ -0,0 +1,54 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"todo-app/domain"
+	"todo-app/pkg/clients"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type postgresAuditor struct {
+	db    *gorm.DB
+	sinks []Sink
+}
+
+// NewPostgresAuditor returns an Auditor that persists events to Postgres and
+// additionally fans each event out to sinks, best-effort.
+func NewPostgresAuditor(db *gorm.DB, sinks ...Sink) Auditor {
+	return &postgresAuditor{
+		db:    db,
+		sinks: sinks,
+	}
+}
+
+func (a *postgresAuditor) Record(ctx context.Context, event AuditEvent) error {
+	metadata, err := json.Marshal(event.Metadata)
+	if err != nil {
+		return err
+	}
+
+	log := domain.AuditLog{
+		ID:         uuid.New(),
+		ActorID:    event.ActorID,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		IP:         event.IP,
+		UserAgent:  event.UserAgent,
+		Metadata:   datatypes.JSON(metadata),
+	}
+
+	if err := a.db.WithContext(ctx).Create(&log).Error; err != nil {
+		return clients.ErrDB(err)
+	}
+
+	for _, sink := range a.sinks {
+		sink.Write(event)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+)
+
+type stdoutSink struct{}
+
+// NewStdoutSink returns a Sink that writes each event as a JSON line to stdout.
+func NewStdoutSink() Sink {
+	return &stdoutSink{}
+}
+
+func (s *stdoutSink) Write(event AuditEvent) {
+	line, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("audit: failed to marshal event for stdout sink: %v", err)
+		return
+	}
+
+	fmt.Println(string(line))
+}
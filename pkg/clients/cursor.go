@@ -0,0 +1,67 @@
+package clients
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const cursorSeparator = "|"
+
+// EncodeCursor packs a sort field's value together with the row's id into an
+// opaque, base64-encoded cursor so seek-based pagination stays stable under
+// concurrent inserts.
+func EncodeCursor(sortValue string, id uuid.UUID) string {
+	raw := sortValue + cursorSeparator + id.String()
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor reverses EncodeCursor. It returns ErrInvalidRequest-wrapped
+// errors so handlers can pass them straight through to the client.
+func DecodeCursor(cursor string) (sortValue string, id uuid.UUID, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", uuid.Nil, ErrInvalidRequest(fmt.Errorf("invalid cursor: %w", err))
+	}
+
+	// Split at the last separator: the id never contains one, the sort value may.
+	sep := strings.LastIndex(string(raw), cursorSeparator)
+	if sep < 0 {
+		return "", uuid.Nil, ErrInvalidRequest(fmt.Errorf("invalid cursor"))
+	}
+
+	id, err = uuid.Parse(string(raw[sep+len(cursorSeparator):]))
+	if err != nil {
+		return "", uuid.Nil, ErrInvalidRequest(fmt.Errorf("invalid cursor: %w", err))
+	}
+
+	return string(raw[:sep]), id, nil
+}
+
+// SeekPredicate translates a column's display sort order (desc) and the page
+// being requested (direction) into the ORDER BY direction and comparison
+// operator a repository should seek with. For DirectionPrev both are
+// flipped relative to the display order, so the caller fetches the rows
+// immediately before the cursor; the caller must then reverse the fetched
+// rows to restore display order.
+func SeekPredicate(desc bool, direction Direction) (queryDesc bool, cmp string) {
+	queryDesc = desc
+	cmp = "<"
+	if !desc {
+		cmp = ">"
+	}
+
+	if direction == DirectionPrev {
+		queryDesc = !queryDesc
+		if cmp == "<" {
+			cmp = ">"
+		} else {
+			cmp = "<"
+		}
+	}
+
+	return queryDesc, cmp
+}
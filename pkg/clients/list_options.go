@@ -0,0 +1,38 @@
+package clients
+
+// SortField names a single column to sort by and its direction.
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// Direction selects which side of Cursor a seek-based GetAll should read:
+// DirectionNext walks toward next_cursor, DirectionPrev walks back toward
+// prev_cursor. A repository must flip its comparison operator and ORDER BY
+// for DirectionPrev and reverse the fetched rows back into display order.
+type Direction string
+
+const (
+	DirectionNext Direction = "next"
+	DirectionPrev Direction = "prev"
+)
+
+// ListOptions carries pagination, sorting and filtering for a GetAll-style
+// repository call. Cursor is an opaque value produced by EncodeCursor; it is
+// never interpreted by callers, only round-tripped back into DecodeCursor.
+// Direction says which page Cursor points at; it is ignored when Cursor is empty.
+type ListOptions struct {
+	Limit     int
+	Cursor    string
+	Direction Direction
+	Sort      []SortField
+	Filters   map[string]any
+}
+
+// ListPage is the paginated response shape returned alongside clients.SuccessRes
+// for any GetAll-style endpoint.
+type ListPage[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor"`
+	PrevCursor string `json:"prev_cursor"`
+}
@@ -0,0 +1,21 @@
+package mailer
+
+import "log"
+
+type logMailer struct{}
+
+// NewLogMailer returns a Mailer that logs the email instead of sending it,
+// for local development and tests.
+func NewLogMailer() Mailer {
+	return &logMailer{}
+}
+
+func (m *logMailer) SendVerificationEmail(to, link string) error {
+	log.Printf("mailer: verification email to %s: %s", to, link)
+	return nil
+}
+
+func (m *logMailer) SendPasswordResetEmail(to, link string) error {
+	log.Printf("mailer: password reset email to %s: %s", to, link)
+	return nil
+}
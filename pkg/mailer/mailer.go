@@ -0,0 +1,7 @@
+package mailer
+
+// Mailer sends the transactional emails the user-verification flows depend on.
+type Mailer interface {
+	SendVerificationEmail(to, link string) error
+	SendPasswordResetEmail(to, link string) error
+}
@@ -0,0 +1,42 @@
+package mailer
+
+import (
+	"fmt"
+	"net/smtp"
+)
+
+type smtpMailer struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPMailer returns a Mailer that sends email through the given SMTP server.
+func NewSMTPMailer(host, port, username, password, from string) Mailer {
+	return &smtpMailer{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+func (m *smtpMailer) SendVerificationEmail(to, link string) error {
+	return m.send(to, "Verify your email", fmt.Sprintf("Click to verify your email: %s", link))
+}
+
+func (m *smtpMailer) SendPasswordResetEmail(to, link string) error {
+	return m.send(to, "Reset your password", fmt.Sprintf("Click to reset your password: %s", link))
+}
+
+func (m *smtpMailer) send(to, subject, body string) error {
+	addr := m.host + ":" + m.port
+	auth := smtp.PlainAuth("", m.username, m.password, m.host)
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s", m.from, to, subject, body)
+
+	return smtp.SendMail(addr, auth, m.from, []string{to}, []byte(msg))
+}
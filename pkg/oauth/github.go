@@ -0,0 +1,105 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"golang.org/x/oauth2"
+	githuboauth "golang.org/x/oauth2/github"
+)
+
+type githubProvider struct {
+	config *oauth2.Config
+}
+
+// NewGitHubProvider builds a Provider that authenticates against GitHub using
+// the "read:user" and "user:email" scopes.
+func NewGitHubProvider(clientID, clientSecret, redirectURL string) Provider {
+	return &githubProvider{
+		config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       []string{"read:user", "user:email"},
+			Endpoint:     githuboauth.Endpoint,
+		},
+	}
+}
+
+func (p *githubProvider) AuthURL(state string) string {
+	return p.config.AuthCodeURL(state, oauth2.AccessTypeOnline)
+}
+
+func (p *githubProvider) Exchange(code string) (UserInfo, error) {
+	ctx := context.Background()
+
+	token, err := p.config.Exchange(ctx, code)
+	if err != nil {
+		return UserInfo{}, fmt.Errorf("oauth: github exchange: %w", err)
+	}
+
+	client := p.config.Client(ctx, token)
+
+	resp, err := client.Get("https://api.github.com/user")
+	if err != nil {
+		return UserInfo{}, fmt.Errorf("oauth: github user: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return UserInfo{}, fmt.Errorf("oauth: github user: %w", err)
+	}
+
+	var profile struct {
+		ID    int64  `json:"id"`
+		Email string `json:"email"`
+		Name  string `json:"name"`
+	}
+	if err := json.Unmarshal(body, &profile); err != nil {
+		return UserInfo{}, fmt.Errorf("oauth: github user decode: %w", err)
+	}
+
+	email := profile.Email
+	if email == "" {
+		email, err = fetchPrimaryGitHubEmail(client)
+		if err != nil {
+			return UserInfo{}, err
+		}
+	}
+
+	return UserInfo{
+		Subject: strconv.FormatInt(profile.ID, 10),
+		Email:   email,
+		Name:    profile.Name,
+	}, nil
+}
+
+func fetchPrimaryGitHubEmail(client *http.Client) (string, error) {
+	resp, err := client.Get("https://api.github.com/user/emails")
+	if err != nil {
+		return "", fmt.Errorf("oauth: github emails: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&emails); err != nil {
+		return "", fmt.Errorf("oauth: github emails decode: %w", err)
+	}
+
+	for _, e := range emails {
+		if e.Primary && e.Verified {
+			return e.Email, nil
+		}
+	}
+
+	return "", fmt.Errorf("oauth: github account has no verified primary email")
+}
@@ -0,0 +1,68 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"golang.org/x/oauth2"
+	googleoauth "golang.org/x/oauth2/google"
+)
+
+type googleProvider struct {
+	config *oauth2.Config
+}
+
+// NewGoogleProvider builds a Provider that authenticates against Google using
+// the standard "openid email profile" scopes.
+func NewGoogleProvider(clientID, clientSecret, redirectURL string) Provider {
+	return &googleProvider{
+		config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       []string{"openid", "email", "profile"},
+			Endpoint:     googleoauth.Endpoint,
+		},
+	}
+}
+
+func (p *googleProvider) AuthURL(state string) string {
+	return p.config.AuthCodeURL(state, oauth2.AccessTypeOnline)
+}
+
+func (p *googleProvider) Exchange(code string) (UserInfo, error) {
+	ctx := context.Background()
+
+	token, err := p.config.Exchange(ctx, code)
+	if err != nil {
+		return UserInfo{}, fmt.Errorf("oauth: google exchange: %w", err)
+	}
+
+	resp, err := p.config.Client(ctx, token).Get("https://www.googleapis.com/oauth2/v3/userinfo")
+	if err != nil {
+		return UserInfo{}, fmt.Errorf("oauth: google userinfo: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return UserInfo{}, fmt.Errorf("oauth: google userinfo: %w", err)
+	}
+
+	var profile struct {
+		Sub   string `json:"sub"`
+		Email string `json:"email"`
+		Name  string `json:"name"`
+	}
+	if err := json.Unmarshal(body, &profile); err != nil {
+		return UserInfo{}, fmt.Errorf("oauth: google userinfo decode: %w", err)
+	}
+
+	return UserInfo{
+		Subject: profile.Sub,
+		Email:   profile.Email,
+		Name:    profile.Name,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package oauth
+
+import "fmt"
+
+// UserInfo is the profile data returned by a provider after a successful exchange.
+type UserInfo struct {
+	Subject string
+	Email   string
+	Name    string
+}
+
+// Provider drives the authorization-code flow for a single OAuth2/OIDC provider.
+type Provider interface {
+	// AuthURL builds the provider's authorize URL, embedding state for CSRF protection.
+	AuthURL(state string) string
+	// Exchange trades an authorization code for the authenticated user's profile.
+	Exchange(code string) (UserInfo, error)
+}
+
+// Registry looks providers up by name, e.g. "google" or "github".
+type Registry map[string]Provider
+
+// Get returns the provider registered under name.
+func (r Registry) Get(name string) (Provider, error) {
+	provider, ok := r[name]
+	if !ok {
+		return nil, fmt.Errorf("oauth: unknown provider %q", name)
+	}
+
+	return provider, nil
+}
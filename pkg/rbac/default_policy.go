@@ -0,0 +1,15 @@
+package rbac
+
+import "todo-app/domain"
+
+// NewDefaultRegistry seeds the policy an admin can do anything to the "user"
+// resource while a plain user can only read it; ownership checks for a
+// user's own record are handled separately by the caller.
+func NewDefaultRegistry() *Registry {
+	registry := NewRegistry()
+
+	registry.Allow(string(domain.RoleAdmin), "user", ActionList, ActionRead, ActionCreate, ActionUpdate, ActionDelete)
+	registry.Allow(string(domain.RoleUser), "user", ActionRead)
+
+	return registry
+}
@@ -0,0 +1,52 @@
+package rbac
+
+import "sync"
+
+// Action is an operation performed on a resource, e.g. "read" or "delete".
+type Action string
+
+const (
+	ActionList   Action = "list"
+	ActionRead   Action = "read"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+type policyKey struct {
+	Role     string
+	Resource string
+	Action   Action
+}
+
+// Registry maps (role, resource, action) triples to an allow/deny decision.
+// A triple with no matching entry is denied by default.
+type Registry struct {
+	mu      sync.RWMutex
+	allowed map[policyKey]bool
+}
+
+// NewRegistry returns an empty policy registry; use Allow to populate it.
+func NewRegistry() *Registry {
+	return &Registry{
+		allowed: map[policyKey]bool{},
+	}
+}
+
+// Allow grants role permission to perform actions on resource.
+func (r *Registry) Allow(role, resource string, actions ...Action) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, action := range actions {
+		r.allowed[policyKey{Role: role, Resource: resource, Action: action}] = true
+	}
+}
+
+// IsAllowed reports whether role may perform action on resource.
+func (r *Registry) IsAllowed(role, resource string, action Action) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.allowed[policyKey{Role: role, Resource: resource, Action: action}]
+}
@@ -0,0 +1,86 @@
+package sessionstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	sessionKeyPrefix = "session:"
+	revokedKeyPrefix = "revoked:"
+)
+
+// ErrNotFound is returned when no session exists for the given jti.
+var ErrNotFound = errors.New("sessionstore: session not found")
+
+type redisSessionStore struct {
+	client *redis.Client
+}
+
+// NewRedisSessionStore returns a SessionStore backed by the given Redis client.
+func NewRedisSessionStore(client *redis.Client) *redisSessionStore {
+	return &redisSessionStore{
+		client: client,
+	}
+}
+
+func (s *redisSessionStore) Save(jti string, session Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Until(session.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("sessionstore: session already expired")
+	}
+
+	return s.client.Set(context.Background(), sessionKeyPrefix+jti, data, ttl).Err()
+}
+
+func (s *redisSessionStore) Get(jti string) (*Session, error) {
+	data, err := s.client.Get(context.Background(), sessionKeyPrefix+jti).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (s *redisSessionStore) Delete(jti string) error {
+	return s.client.Del(context.Background(), sessionKeyPrefix+jti).Err()
+}
+
+func (s *redisSessionStore) Revoke(jti string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	return s.client.Set(context.Background(), revokedKeyPrefix+jti, true, ttl).Err()
+}
+
+func (s *redisSessionStore) IsRevoked(jti string) (bool, error) {
+	err := s.client.Get(context.Background(), revokedKeyPrefix+jti).Err()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
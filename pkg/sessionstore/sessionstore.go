@@ -0,0 +1,30 @@
+package sessionstore
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session represents the server-side state kept for an issued refresh token.
+type Session struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Jti       string    `json:"jti"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// SessionStore persists refresh-token sessions and tracks revoked token IDs so
+// the auth middleware can reject access tokens after a logout.
+type SessionStore interface {
+	// Save stores a session keyed by its jti, expiring it at session.ExpiresAt.
+	Save(jti string, session Session) error
+	// Get returns the session for jti, or an error if it does not exist or has expired.
+	Get(jti string) (*Session, error)
+	// Delete removes the session for jti, e.g. when a refresh token is consumed.
+	Delete(jti string) error
+	// Revoke marks jti as revoked until expiresAt, regardless of whether a
+	// session was ever stored for it. Used to blacklist access tokens on logout.
+	Revoke(jti string, expiresAt time.Time) error
+	// IsRevoked reports whether jti has been revoked.
+	IsRevoked(jti string) (bool, error)
+}
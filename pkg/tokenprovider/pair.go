@@ -0,0 +1,8 @@
+package tokenprovider
+
+// TokenPair bundles a short-lived access token with a longer-lived refresh
+// token so a client can renew its session without re-authenticating.
+type TokenPair struct {
+	AccessToken  Token `json:"access_token"`
+	RefreshToken Token `json:"refresh_token"`
+}